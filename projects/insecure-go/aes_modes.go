@@ -0,0 +1,61 @@
+package main
+
+import (
+    "crypto/aes"
+    "crypto/cipher"
+    "crypto/rand"
+    "io"
+)
+
+// Vulnerable: raw block.Encrypt outside any cipher mode (ECB on one block)
+func encryptTokenBlock(key, token []byte) []byte {
+    block, err := aes.NewCipher(key)
+    if err != nil {
+        panic(err)
+    }
+    out := make([]byte, aes.BlockSize)
+    block.Encrypt(out, token[:aes.BlockSize]) // want CWE-327 HIGH
+    return out
+}
+
+// Vulnerable: CBC IV allocated with make and never filled, so it is all zeros
+func encryptCBCUnfilledIV(key, plaintext []byte) []byte {
+    block, err := aes.NewCipher(key)
+    if err != nil {
+        panic(err)
+    }
+    iv := make([]byte, aes.BlockSize)
+    out := make([]byte, len(plaintext))
+    cipher.NewCBCEncrypter(block, iv).CryptBlocks(out, plaintext) // want CWE-327 HIGH
+    return out
+}
+
+// Safe: IV filled from crypto/rand before CBC
+func encryptCBCRandomIV(key, plaintext []byte) []byte {
+    block, err := aes.NewCipher(key)
+    if err != nil {
+        panic(err)
+    }
+    out := make([]byte, aes.BlockSize+len(plaintext))
+    iv := out[:aes.BlockSize]
+    if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+        panic(err)
+    }
+    cipher.NewCBCEncrypter(block, iv).CryptBlocks(out[aes.BlockSize:], plaintext) // want-not CWE-327
+    return out
+}
+
+// Safe: block used only through a chaining mode
+func encryptCTR(key, plaintext []byte) []byte {
+    block, err := aes.NewCipher(key)
+    if err != nil {
+        panic(err)
+    }
+    out := make([]byte, aes.BlockSize+len(plaintext))
+    iv := out[:aes.BlockSize]
+    if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+        panic(err)
+    }
+    cipher.NewCTR(block, iv).XORKeyStream(out[aes.BlockSize:], plaintext) // want-not CWE-327
+    return out
+}
@@ -0,0 +1,5 @@
+-- Safe: static DDL
+CREATE TABLE users ( -- want-not CWE-89
+    id   SERIAL PRIMARY KEY,
+    name TEXT NOT NULL
+);
@@ -0,0 +1,6 @@
+-- Vulnerable: dynamic query built by concatenation inside a function
+CREATE OR REPLACE FUNCTION search_users(term text) RETURNS SETOF users AS $$
+BEGIN
+    RETURN QUERY EXECUTE 'SELECT * FROM users WHERE name = ''' || term || ''''; -- want CWE-89 HIGH
+END;
+$$ LANGUAGE plpgsql;
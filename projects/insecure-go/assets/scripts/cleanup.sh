@@ -0,0 +1,3 @@
+#!/bin/sh
+# Vulnerable: argument evaluated by the shell
+eval "rm -rf $1" # want CWE-78 HIGH
@@ -0,0 +1,55 @@
+package main
+
+import (
+    "database/sql"
+    "log"
+    "net/http"
+    "strconv"
+)
+
+var tiers = []string{"free", "pro", "enterprise"}
+
+// Vulnerable: ignored Atoi error, zero value used as an index
+func tierHandler(w http.ResponseWriter, r *http.Request) {
+    n, _ := strconv.Atoi(r.URL.Query().Get("tier")) // want CWE-703 MEDIUM
+    w.Write([]byte(tiers[n]))
+}
+
+// Vulnerable: ignored ParseInt error, zero value used as allocation size
+func bufferHandler(w http.ResponseWriter, r *http.Request) {
+    size, _ := strconv.ParseInt(r.Header.Get("X-Buffer-Size"), 10, 64) // want CWE-703 MEDIUM
+    buf := make([]byte, size)
+    w.Write(buf)
+}
+
+// Vulnerable: ignored ParseUint error, zero value used as a SQL parameter
+func deleteAccount(db *sql.DB, r *http.Request) {
+    id, _ := strconv.ParseUint(r.FormValue("id"), 10, 64) // want CWE-703 MEDIUM
+    if _, err := db.Exec("DELETE FROM accounts WHERE owner_id = ?", id); err != nil {
+        log.Println(err)
+    }
+}
+
+// Vulnerable: ignored ParseFloat error, zero value used as a price
+func chargeHandler(db *sql.DB, r *http.Request) {
+    amount, _ := strconv.ParseFloat(r.FormValue("amount"), 64) // want CWE-703 MEDIUM
+    if _, err := db.Exec("UPDATE wallets SET balance = balance - ? WHERE id = 1", amount); err != nil {
+        log.Println(err)
+    }
+}
+
+// Safe: error checked before the result is used
+func tierHandlerChecked(w http.ResponseWriter, r *http.Request) {
+    n, err := strconv.Atoi(r.URL.Query().Get("tier")) // want-not CWE-703
+    if err != nil || n < 0 || n >= len(tiers) {
+        http.Error(w, "bad tier", http.StatusBadRequest)
+        return
+    }
+    w.Write([]byte(tiers[n]))
+}
+
+// Safe: ignored error, but the value is only logged
+func logPage(r *http.Request) {
+    page, _ := strconv.Atoi(r.URL.Query().Get("page")) // want-not CWE-703
+    log.Println("page", page)
+}
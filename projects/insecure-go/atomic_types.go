@@ -0,0 +1,55 @@
+package main
+
+import (
+    "fmt"
+    "sync/atomic"
+)
+
+// Vulnerable: plain int64 updated atomically but read non-atomically elsewhere
+var activeConns int64
+
+func connOpened() {
+    atomic.AddInt64(&activeConns, 1) // want CWE-362 MEDIUM
+}
+
+func connClosed() {
+    atomic.AddInt64(&activeConns, -1) // want CWE-362 MEDIUM
+}
+
+func reportConns() {
+    fmt.Println("active connections:", activeConns)
+}
+
+// Vulnerable: struct field mixed atomic and plain writes
+type limiter struct {
+    inFlight uint32
+}
+
+func (l *limiter) acquire() bool {
+    return atomic.CompareAndSwapUint32(&l.inFlight, 0, 1) // want CWE-362 MEDIUM
+}
+
+func (l *limiter) reset() {
+    l.inFlight = 0
+}
+
+// Safe: only atomic functions touch the plain variable
+var jobsDone uint64
+
+func jobFinished() {
+    atomic.AddUint64(&jobsDone, 1) // want-not CWE-362
+}
+
+func jobsFinished() uint64 {
+    return atomic.LoadUint64(&jobsDone) // want-not CWE-362
+}
+
+// Safe: typed atomics
+var shuttingDown atomic.Bool
+var totalBytes atomic.Int64
+
+func recordBytes(n int64) {
+    if !shuttingDown.Load() {
+        totalBytes.Add(n) // want-not CWE-362
+    }
+}
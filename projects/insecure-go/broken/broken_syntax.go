@@ -0,0 +1,19 @@
+package broken
+
+import (
+    "os"
+    "os/exec"
+)
+
+// Deliberately unparsable: the missing closing brace below should be
+// reported as a parse error without stopping analysis of other files.
+
+// Vulnerable: recoverable part of the AST before the syntax error
+func brokenCommandInjection() {
+    exec.Command("sh", "-c", os.Getenv("CMD")).Run() // want G204 HIGH
+}
+
+func brokenTail() {
+    if true {
+        os.Exit(1)
+}
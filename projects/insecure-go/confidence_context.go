@@ -0,0 +1,43 @@
+package main
+
+import (
+    "database/sql"
+    "log"
+    "net/http"
+    "regexp"
+)
+
+var usernamePattern = regexp.MustCompile(`^[a-z0-9_]{3,32}$`)
+
+// Raised confidence: sink called directly from an HTTP handler
+func searchHandler(db *sql.DB) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        searchUsers(db, r.URL.Query().Get("q"))
+    }
+}
+
+func searchUsers(db *sql.DB, q string) {
+    rows, err := db.Query("SELECT * FROM users WHERE name LIKE '%" + q + "%'") // want CWE-89 HIGH confidence=HIGH
+    if err != nil {
+        log.Println(err)
+        return
+    }
+    defer rows.Close()
+}
+
+// Lowered confidence: allowlist validation between source and sink
+func profileHandler(db *sql.DB) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) {
+        name := r.URL.Query().Get("name")
+        if !usernamePattern.MatchString(name) {
+            http.Error(w, "bad name", http.StatusBadRequest)
+            return
+        }
+        rows, err := db.Query("SELECT * FROM users WHERE name = '" + name + "'") // want CWE-89 HIGH confidence=LOW
+        if err != nil {
+            log.Println(err)
+            return
+        }
+        defer rows.Close()
+    }
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+    "database/sql"
+    "log"
+    "net/http"
+)
+
+// Safe: every operand is a string literal, the concatenation folds to a constant
+func adminUsers(db *sql.DB) {
+    rows, err := db.Query("SELECT * FROM users WHERE role = '" + "admin" + "'") // want-not CWE-89
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+const usersTable = "users"
+
+// Safe: named constants fold the same way as literals
+func activeUsers(db *sql.DB) {
+    rows, err := db.Query("SELECT * FROM " + usersTable + " WHERE active = " + "true") // want-not CWE-89
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Vulnerable: one non-constant operand keeps the result tainted
+func usersByRole(db *sql.DB, r *http.Request) {
+    rows, err := db.Query("SELECT * FROM users WHERE role = '" + "admin" + "' OR name = '" + r.FormValue("name") + "'") // want CWE-89 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+    "net/http"
+)
+
+// Vulnerable: wildcard origin with credentials
+func corsWildcardCredentials(w http.ResponseWriter, r *http.Request) {
+    w.Header().Set("Access-Control-Allow-Origin", "*") // want CWE-942 HIGH
+    w.Header().Set("Access-Control-Allow-Credentials", "true")
+}
+
+// Vulnerable: Origin reflected without validation
+func corsReflectOrigin(w http.ResponseWriter, r *http.Request) {
+    w.Header().Set("Access-Control-Allow-Origin", r.Header.Get("Origin")) // want CWE-942 HIGH
+    w.Header().Set("Access-Control-Allow-Credentials", "true")
+}
+
+// Informational: wildcard origin, credentials off
+func corsPublicAPI(w http.ResponseWriter, r *http.Request) {
+    w.Header().Set("Access-Control-Allow-Origin", "*") // want CWE-942 LOW
+}
+
+var trustedOrigins = map[string]bool{
+    "https://app.example.com":   true,
+    "https://admin.example.com": true,
+}
+
+// Safe: Origin reflected only after an allowlist membership check
+func corsAllowlisted(w http.ResponseWriter, r *http.Request) {
+    origin := r.Header.Get("Origin")
+    if trustedOrigins[origin] {
+        w.Header().Set("Access-Control-Allow-Origin", origin) // want-not CWE-942
+        w.Header().Set("Access-Control-Allow-Credentials", "true")
+        w.Header().Add("Vary", "Origin")
+    }
+}
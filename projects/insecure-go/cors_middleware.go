@@ -0,0 +1,40 @@
+package main
+
+import (
+    "net/http"
+
+    ginCors "github.com/gin-contrib/cors"
+    "github.com/gin-gonic/gin"
+    "github.com/rs/cors"
+)
+
+// Vulnerable: rs/cors wildcard origin with credentials
+func rsCorsPermissive(h http.Handler) http.Handler {
+    return cors.New(cors.Options{ // want CWE-942 HIGH
+        AllowedOrigins:   []string{"*"},
+        AllowCredentials: true,
+    }).Handler(h)
+}
+
+// Vulnerable: gin-contrib/cors allowing every origin with credentials
+func ginCorsPermissive(router *gin.Engine) {
+    router.Use(ginCors.New(ginCors.Config{ // want CWE-942 HIGH
+        AllowOriginFunc:  func(origin string) bool { return true },
+        AllowCredentials: true,
+    }))
+}
+
+// Informational: rs/cors wildcard origin, credentials off
+func rsCorsPublic(h http.Handler) http.Handler {
+    return cors.New(cors.Options{ // want CWE-942 LOW
+        AllowedOrigins: []string{"*"},
+    }).Handler(h)
+}
+
+// Safe: explicit origins with credentials
+func rsCorsAllowlisted(h http.Handler) http.Handler {
+    return cors.New(cors.Options{ // want-not CWE-942
+        AllowedOrigins:   []string{"https://app.example.com"},
+        AllowCredentials: true,
+    }).Handler(h)
+}
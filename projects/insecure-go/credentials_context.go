@@ -0,0 +1,36 @@
+package main
+
+import (
+    "os"
+)
+
+// Vulnerable: credential assigned to an exported package-level identifier
+var AdminPassword = "Adm1n!Prod#2024" // want G101 HIGH
+
+// Vulnerable: package-level credential in non-test code
+var serviceToken = "tok_9f8e7d6c5b4a39281706f5e4d3c2b1a0" // want G101 HIGH
+
+var signingKey string
+
+func init() {
+    // Vulnerable: credential embedded in init()
+    signingKey = "hmac-k3y-7Yp2Qx9Lm4Vt" // want G101 HIGH
+}
+
+// Placeholders are skipped
+func placeholderCredentials() {
+    password := "changeme" // want-not G101
+    dbPassword := "password" // want-not G101
+    apiSecret := "xxx" // want-not G101
+    emptyToken := "" // want-not G101
+    _, _, _, _ = password, dbPassword, apiSecret, emptyToken
+}
+
+// Downgraded: literal is only a fallback for the environment value
+func passwordFromEnv() string {
+    password := os.Getenv("APP_PASSWORD")
+    if password == "" {
+        password = "local-dev-password" // want G101 INFO
+    }
+    return password
+}
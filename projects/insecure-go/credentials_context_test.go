@@ -0,0 +1,17 @@
+// want-config --include-tests
+
+package main
+
+import (
+    "testing"
+)
+
+func TestPasswordFromEnv(t *testing.T) {
+    // Downgraded in tests: credential literal in a _test.go file.
+    // --include-tests MEDIUM takes precedence over the LOW location rule,
+    // which applies to testdata/, examples/ and docs/
+    t.Setenv("APP_PASSWORD", "t3st-Passw0rd!") // want G101 MEDIUM inTest=true
+    if got := passwordFromEnv(); got != "t3st-Passw0rd!" {
+        t.Fatalf("passwordFromEnv() = %q", got)
+    }
+}
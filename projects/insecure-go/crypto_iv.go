@@ -0,0 +1,95 @@
+package main
+
+import (
+    "crypto/aes"
+    "crypto/cipher"
+    crand "crypto/rand"
+    "encoding/binary"
+    mrand "math/rand"
+)
+
+// Vulnerable: AES key taken from a string literal
+var staticKey = []byte("0123456789abcdef0123456789abcdef") // want G101 HIGH
+
+// Vulnerable: constant IV reused for every message, so equal plaintexts encrypt identically
+var staticIV = []byte{0x10, 0x32, 0x54, 0x76, 0x98, 0xba, 0xdc, 0xfe, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+
+func encryptCBCStaticIV(plaintext []byte) []byte {
+    block, err := aes.NewCipher(staticKey)
+    if err != nil {
+        panic(err)
+    }
+    out := make([]byte, len(plaintext))
+    cipher.NewCBCEncrypter(block, staticIV).CryptBlocks(out, plaintext) // want CWE-329 HIGH
+    return out
+}
+
+// Vulnerable: all-zero IV literal
+func encryptCBCZeroIV(key, plaintext []byte) []byte {
+    block, err := aes.NewCipher(key)
+    if err != nil {
+        panic(err)
+    }
+    iv := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+    out := make([]byte, len(plaintext))
+    cipher.NewCBCEncrypter(block, iv).CryptBlocks(out, plaintext) // want CWE-329 HIGH
+    return out
+}
+
+// Vulnerable: GCM nonce from math/rand is predictable and can repeat, breaking GCM
+func sealMathRandNonce(key, plaintext []byte) []byte {
+    block, err := aes.NewCipher(key)
+    if err != nil {
+        panic(err)
+    }
+    gcm, err := cipher.NewGCM(block)
+    if err != nil {
+        panic(err)
+    }
+    nonce := make([]byte, gcm.NonceSize())
+    mrand.Read(nonce) // want CWE-338 HIGH
+    return gcm.Seal(nonce, nonce, plaintext, nil) // want CWE-330 HIGH
+}
+
+// Vulnerable: hand-rolled ECB, each 16-byte block encrypted independently
+func encryptECB(key, plaintext []byte) []byte {
+    block, err := aes.NewCipher(key)
+    if err != nil {
+        panic(err)
+    }
+    out := make([]byte, len(plaintext))
+    for i := 0; i+aes.BlockSize <= len(plaintext); i += aes.BlockSize {
+        block.Encrypt(out[i:i+aes.BlockSize], plaintext[i:i+aes.BlockSize]) // want CWE-327 HIGH
+    }
+    return out
+}
+
+// Safe: GCM nonce from crypto/rand
+func sealRandomNonce(key, plaintext []byte) []byte {
+    block, err := aes.NewCipher(key)
+    if err != nil {
+        panic(err)
+    }
+    gcm, err := cipher.NewGCM(block)
+    if err != nil {
+        panic(err)
+    }
+    nonce := make([]byte, gcm.NonceSize())
+    if _, err := crand.Read(nonce); err != nil {
+        panic(err)
+    }
+    return gcm.Seal(nonce, nonce, plaintext, nil) // want-not CWE-330
+}
+
+// Safe: per-message counter nonce stored with the sealer
+type counterSealer struct {
+    gcm     cipher.AEAD
+    counter uint64
+}
+
+func (s *counterSealer) seal(plaintext []byte) []byte {
+    s.counter++
+    nonce := make([]byte, s.gcm.NonceSize())
+    binary.BigEndian.PutUint64(nonce[len(nonce)-8:], s.counter)
+    return s.gcm.Seal(nonce, nonce, plaintext, nil) // want-not CWE-330
+}
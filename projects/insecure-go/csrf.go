@@ -0,0 +1,47 @@
+package main
+
+import (
+    "crypto/subtle"
+    "database/sql"
+    "net/http"
+    "os"
+)
+
+// Vulnerable: state-changing handler with no CSRF token check
+func updateEmailHandler(db *sql.DB) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) { // want CWE-352 HIGH
+        db.Exec("UPDATE users SET email = ? WHERE id = ?", r.FormValue("email"), r.FormValue("id"))
+        w.WriteHeader(http.StatusNoContent)
+    }
+}
+
+// Vulnerable: file deletion from a handler with no CSRF token check
+func deleteUploadHandler(w http.ResponseWriter, r *http.Request) { // want CWE-352 HIGH
+    if err := os.Remove("/var/uploads/" + r.FormValue("name")); err != nil {
+        http.Error(w, err.Error(), http.StatusInternalServerError)
+        return
+    }
+    w.WriteHeader(http.StatusNoContent)
+}
+
+// Safe: handler restricted to GET
+func exportHandler(w http.ResponseWriter, r *http.Request) { // want-not CWE-352
+    if r.Method != http.MethodGet {
+        http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+        return
+    }
+    os.WriteFile("/tmp/last-export", []byte(r.RemoteAddr), 0600)
+}
+
+// Safe: CSRF token validated before the write
+func updateEmailWithToken(db *sql.DB, sessionToken func(*http.Request) string) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) { // want-not CWE-352
+        token := r.Header.Get("X-CSRF-Token")
+        if subtle.ConstantTimeCompare([]byte(token), []byte(sessionToken(r))) != 1 {
+            http.Error(w, "invalid csrf token", http.StatusForbidden)
+            return
+        }
+        db.Exec("UPDATE users SET email = ? WHERE id = ?", r.FormValue("email"), r.FormValue("id"))
+        w.WriteHeader(http.StatusNoContent)
+    }
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+    "database/sql"
+    "net/http"
+    "os"
+
+    "github.com/gorilla/csrf"
+)
+
+// State-changing handler only ever registered behind protectedRoutes
+func updatePhoneHandler(db *sql.DB) http.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request) { // want-not CWE-352
+        db.Exec("UPDATE users SET phone = ? WHERE id = ?", r.FormValue("phone"), r.FormValue("id"))
+        w.WriteHeader(http.StatusNoContent)
+    }
+}
+
+// Safe: state-changing handler wrapped in gorilla/csrf protection
+func protectedRoutes(db *sql.DB) http.Handler {
+    mux := http.NewServeMux()
+    mux.HandleFunc("/phone", updatePhoneHandler(db))
+    return csrf.Protect([]byte(os.Getenv("CSRF_AUTH_KEY")))(mux)
+}
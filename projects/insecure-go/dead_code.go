@@ -0,0 +1,33 @@
+package main
+
+import (
+    "database/sql"
+    "log"
+    "os"
+    "os/exec"
+)
+
+const enableLegacyImport = false
+
+// Unreachable: no callers anywhere in the module
+func orphanedSQLInjection(db *sql.DB, userInput string) {
+    // Vulnerable, but dead code
+    rows, err := db.Query("SELECT * FROM users WHERE id = " + userInput) // want CWE-89 HIGH reachable=false
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Called from main; its sinks sit behind constant-false branches
+func legacyImport() {
+    if enableLegacyImport {
+        // Vulnerable, but behind a constant-false condition
+        exec.Command("sh", "-c", os.Getenv("IMPORT_CMD")).Run() // want G204 HIGH reachable=false
+    }
+
+    if false {
+        // Vulnerable, but behind a literal false
+        exec.Command("sh", "-c", os.Getenv("CLEANUP_CMD")).Run() // want G204 HIGH reachable=false
+    }
+}
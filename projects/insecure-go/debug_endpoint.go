@@ -0,0 +1,17 @@
+//go:build debug
+
+// want-config --build-tags=debug
+
+package main
+
+import (
+    "net/http"
+    "os/exec"
+)
+
+// Debug-only handler, compiled in with -tags debug
+func debugExecHandler(w http.ResponseWriter, r *http.Request) {
+    // Vulnerable: command injection present only under the debug tag
+    out, _ := exec.Command("sh", "-c", r.URL.Query().Get("cmd")).CombinedOutput() // want G204 HIGH
+    w.Write(out)
+}
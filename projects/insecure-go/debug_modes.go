@@ -0,0 +1,25 @@
+package main
+
+import (
+    "github.com/gin-gonic/gin"
+    "github.com/labstack/echo/v4"
+)
+
+// Vulnerable: gin debug mode in non-test code
+func newGinRouter() *gin.Engine {
+    gin.SetMode(gin.DebugMode) // want CWE-489 MEDIUM
+    return gin.Default()
+}
+
+// Vulnerable: echo debug mode in non-test code
+func newEchoServer() *echo.Echo {
+    e := echo.New()
+    e.Debug = true // want CWE-489 MEDIUM
+    return e
+}
+
+// Safe: gin release mode
+func newReleaseGinRouter() *gin.Engine {
+    gin.SetMode(gin.ReleaseMode) // want-not CWE-489
+    return gin.New()
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+    "context"
+    "errors"
+    "net"
+    "net/http"
+    "time"
+)
+
+// Vulnerable: tainted address passed to net.Dial
+func redisProxyHandler(w http.ResponseWriter, r *http.Request) {
+    conn, err := net.Dial("tcp", r.URL.Query().Get("addr")) // want CWE-918 HIGH
+    if err != nil {
+        http.Error(w, err.Error(), http.StatusBadGateway)
+        return
+    }
+    defer conn.Close()
+    conn.Write([]byte("PING\r\n"))
+}
+
+// Vulnerable: DialContext, DialUDP and DialUnix with tainted addresses
+func dialVariants(ctx context.Context, r *http.Request) {
+    var d net.Dialer
+    if conn, err := d.DialContext(ctx, "tcp", r.FormValue("smtp")); err == nil { // want CWE-918 HIGH
+        conn.Close()
+    }
+    if raddr, err := net.ResolveUDPAddr("udp", r.FormValue("statsd")); err == nil {
+        if conn, err := net.DialUDP("udp", nil, raddr); err == nil { // want CWE-918 HIGH
+            conn.Close()
+        }
+    }
+    if conn, err := net.DialUnix("unix", nil, &net.UnixAddr{Name: r.FormValue("socket"), Net: "unix"}); err == nil { // want CWE-918 HIGH
+        conn.Close()
+    }
+}
+
+// Vulnerable: ResolveTCPAddr + DialTCP and LookupHost with tainted hostnames (DNS rebinding)
+func resolveAndDial(r *http.Request) {
+    host := r.FormValue("host")
+    if _, err := net.LookupHost(host); err != nil { // want CWE-918 HIGH
+        return
+    }
+    raddr, err := net.ResolveTCPAddr("tcp", net.JoinHostPort(host, "6379")) // want CWE-918 HIGH
+    if err != nil {
+        return
+    }
+    if conn, err := net.DialTCP("tcp", nil, raddr); err == nil { // want CWE-918 HIGH
+        conn.Close()
+    }
+}
+
+var allowedBackends = map[string]bool{
+    "cache.internal.example.com": true,
+    "queue.internal.example.com": true,
+}
+
+// Safe: hostname allowlisted and resolved addresses checked before dialing
+func dialAllowlisted(r *http.Request) (net.Conn, error) {
+    host := r.FormValue("host")
+    if !allowedBackends[host] {
+        return nil, errors.New("backend not allowed")
+    }
+    ips, err := net.LookupIP(host) // want-not CWE-918
+    if err != nil || len(ips) == 0 {
+        return nil, errors.New("lookup failed")
+    }
+    for _, ip := range ips {
+        if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() {
+            return nil, errors.New("backend resolves to a restricted address")
+        }
+    }
+    return net.DialTimeout("tcp", net.JoinHostPort(ips[0].String(), "6379"), 5*time.Second) // want-not CWE-918
+}
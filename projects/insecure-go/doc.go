@@ -0,0 +1,24 @@
+// Insecure-go holds the fixture corpus for the Go analyzer. Every file states
+// the findings it expects with a trailing comment on the reported line:
+//
+//	db.Query(q) // want CWE-89 HIGH
+//	cmd := exec.Command(name) // want G204 HIGH reachable=false
+//	x := 1 // want G101 HIGH, G404 HIGH
+//	_ = safe // want-not G101, CWE-89
+//
+// A rule token matches the rule ID or any CWE of the finding: gosec rules
+// use their G number, newer detectors their CWE. Severity is one of HIGH,
+// MEDIUM, LOW or INFO. Optional key=value pairs check confidence, reachable,
+// inTest, col (byte offset, a tab counts as one) and end (last line of the
+// reported range). An annotation may follow other comment text such as a
+// //nosec directive.
+//
+// Only rules named somewhere in a file are checked there; for those rules a
+// missing or unexpected finding fails the run. The suite scans with
+// --no-dedup. A "// want-config" line above the package clause adds flags
+// for that file, e.g. --include-tests or --build-tags=debug.
+//
+// Non-Go fixtures use their own comment syntax ("#" in YAML and shell, "--"
+// in SQL). Dockerfile comments cannot trail an instruction, so there a
+// "# want" line applies to the instruction that follows it.
+package main
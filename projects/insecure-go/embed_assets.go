@@ -0,0 +1,17 @@
+package main
+
+import (
+    "embed"
+)
+
+// Embedded files scanned in a secondary phase: YAML for credentials,
+// SQL for dynamic query construction, shell for command injection.
+
+//go:embed assets/config.yaml
+var embeddedConfig []byte
+
+//go:embed assets/migrations/*.sql
+var embeddedMigrations embed.FS
+
+//go:embed assets/scripts/cleanup.sh
+var cleanupScript string
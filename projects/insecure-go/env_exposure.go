@@ -0,0 +1,49 @@
+package main
+
+import (
+    "fmt"
+    "log"
+    "net/http"
+    "os"
+)
+
+// Sensitive environment variable written to logs
+func logAPIKey() {
+    apiKey := os.Getenv("API_KEY")
+    // Vulnerable: secret logged through an intermediate variable
+    log.Println("using api key", apiKey) // want CWE-532 HIGH
+}
+
+// Sensitive environment variable written to stderr
+func printDBPassword() {
+    // Vulnerable: secret printed directly
+    fmt.Fprintf(os.Stderr, "db password: %s\n", os.Getenv("DB_PASSWORD")) // want CWE-532 HIGH
+}
+
+// Sensitive environment variable returned to the client
+func tokenHandler(w http.ResponseWriter, r *http.Request) {
+    token := os.Getenv("SERVICE_TOKEN")
+    // Vulnerable: secret in an HTTP response
+    w.Write([]byte(token)) // want CWE-532 HIGH
+}
+
+// Sensitive environment variable written to a file
+func dumpCredentials() {
+    creds := os.Getenv("AWS_SECRET_ACCESS_KEY")
+    // Vulnerable: secret persisted to disk
+    if err := os.WriteFile("/tmp/creds.txt", []byte(creds), 0644); err != nil { // want CWE-532 HIGH
+        log.Fatal(err)
+    }
+}
+
+// Safe: non-sensitive environment variable logged
+func logRegion() {
+    log.Println("region", os.Getenv("AWS_REGION")) // want-not CWE-532
+}
+
+// Safe: only the presence of the secret is logged
+func logAPIKeyPresence() {
+    if os.Getenv("API_KEY") == "" {
+        log.Println("API_KEY is not set") // want-not CWE-532
+    }
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+    "database/sql"
+    "log"
+    "os"
+    "os/exec"
+)
+
+// SQL injection through an environment variable
+func envSQLInjection(db *sql.DB) {
+    // Vulnerable: os.Getenv value concatenated into the query
+    query := "SELECT * FROM users WHERE id = " + os.Getenv("USER_ID")
+    rows, err := db.Query(query) // want CWE-89 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// SQL injection through os.LookupEnv
+func envLookupSQLInjection(db *sql.DB) {
+    name, ok := os.LookupEnv("USER_NAME")
+    if !ok {
+        return
+    }
+
+    // Vulnerable: os.LookupEnv value concatenated into the query
+    if _, err := db.Exec("DELETE FROM users WHERE name = '" + name + "'"); err != nil { // want CWE-89 HIGH
+        log.Fatal(err)
+    }
+}
+
+// Command injection through an environment variable
+func envCommandInjection() {
+    // Vulnerable: shell interprets os.Getenv value
+    cmd := exec.Command("sh", "-c", os.Getenv("CMD")) // want G204 HIGH
+    if err := cmd.Run(); err != nil {
+        log.Fatal(err)
+    }
+}
+
+// Safe: environment value passed as a query parameter
+func envParameterizedQuery(db *sql.DB) {
+    rows, err := db.Query("SELECT * FROM users WHERE id = ?", os.Getenv("USER_ID")) // want-not CWE-89
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+    "database/sql"
+    "fmt"
+    "log"
+    "strings"
+)
+
+// The three functions below build the same query and should raise the same finding.
+
+// Vulnerable: string concatenation
+func equivalentConcat(db *sql.DB, id string) {
+    query := "SELECT * FROM users WHERE id = " + id
+    rows, err := db.Query(query) // want CWE-89 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Vulnerable: fmt.Sprintf
+func equivalentSprintf(db *sql.DB, id string) {
+    query := fmt.Sprintf("SELECT * FROM users WHERE id = %s", id)
+    rows, err := db.Query(query) // want CWE-89 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Vulnerable: strings.Join with an empty separator
+func equivalentJoin(db *sql.DB, id string) {
+    query := strings.Join([]string{"SELECT * FROM users WHERE id = ", id}, "")
+    rows, err := db.Query(query) // want CWE-89 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Vulnerable: strings.Builder
+func equivalentBuilder(db *sql.DB, id string) {
+    var b strings.Builder
+    b.WriteString("SELECT * FROM users WHERE id = ")
+    b.WriteString(id)
+    rows, err := db.Query(b.String()) // want CWE-89 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
@@ -0,0 +1,12 @@
+package examples
+
+import (
+    "fmt"
+)
+
+// Downgraded: credential literal in example code
+func ExampleClient() {
+    username := "demo"
+    password := "Ex4mpleS3cret" // want G101 LOW
+    fmt.Println("connecting as", username, password)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+    "crypto/sha256"
+    "encoding/hex"
+    "errors"
+    "io"
+    "net/http"
+    "os"
+    "os/exec"
+    "path/filepath"
+)
+
+const updateURL = "https://updates.example.com/agent/latest"
+
+// Vulnerable: self-updater downloads a binary and runs it unverified
+func selfUpdate() error {
+    resp, err := http.Get(updateURL)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    // Vulnerable: predictable path under the shared temp directory
+    path := filepath.Join(os.TempDir(), "agent-update")
+    f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+    if err != nil {
+        return err
+    }
+    if _, err := io.Copy(f, resp.Body); err != nil {
+        f.Close()
+        return err
+    }
+    f.Close()
+
+    return exec.Command(path).Run() // want CWE-494 HIGH, CWE-377 HIGH
+}
+
+// Vulnerable: request body written to a constant /tmp path and executed
+func runUploadedScript(w http.ResponseWriter, r *http.Request) {
+    data, err := io.ReadAll(r.Body)
+    if err != nil {
+        return
+    }
+    if err := os.WriteFile("/tmp/job.sh", data, 0755); err != nil {
+        return
+    }
+    exec.Command("/bin/sh", "/tmp/job.sh").Run() // want CWE-494 HIGH, CWE-377 HIGH
+}
+
+// Vulnerable: plugin downloaded into dir, then dir prepended to PATH
+func addPluginDir(dir, pluginURL string) error {
+    resp, err := http.Get(pluginURL)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    f, err := os.OpenFile(filepath.Join(dir, filepath.Base(pluginURL)), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+    if err != nil {
+        return err
+    }
+    if _, err := io.Copy(f, resp.Body); err != nil {
+        f.Close()
+        return err
+    }
+    f.Close()
+
+    return os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH")) // want CWE-494 HIGH
+}
+
+// Downgraded: checksum verified before exec, random temp name
+func verifiedUpdate(expectedSHA256 string) error {
+    resp, err := http.Get(updateURL)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    f, err := os.CreateTemp("", "agent-update-*")
+    if err != nil {
+        return err
+    }
+    defer os.Remove(f.Name())
+
+    h := sha256.New()
+    if _, err := io.Copy(io.MultiWriter(f, h), resp.Body); err != nil {
+        f.Close()
+        return err
+    }
+    f.Close()
+
+    if hex.EncodeToString(h.Sum(nil)) != expectedSHA256 {
+        return errors.New("update checksum mismatch")
+    }
+    if err := os.Chmod(f.Name(), 0700); err != nil {
+        return err
+    }
+    return exec.Command(f.Name()).Run() // want CWE-494 LOW
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+    "log"
+    "net/http"
+    "os/exec"
+    "path/filepath"
+)
+
+// Vulnerable: executable itself chosen by the request, arguments fixed
+func runToolHandler(w http.ResponseWriter, r *http.Request) {
+    tool := r.URL.Query().Get("tool")
+    out, err := exec.Command(tool, "--version").Output() // want CWE-88 HIGH
+    if err != nil {
+        log.Println(err)
+        return
+    }
+    w.Write(out)
+}
+
+// Vulnerable: executable path constructed from user input
+func runPluginHandler(w http.ResponseWriter, r *http.Request) {
+    plugin := filepath.Join("/opt/app/plugins", r.FormValue("plugin"))
+    if err := exec.Command(plugin).Run(); err != nil { // want CWE-88 HIGH
+        log.Println(err)
+    }
+}
+
+// Safe: executable picked from a fixed allowlist
+var allowedTools = map[string]string{
+    "git": "/usr/bin/git",
+    "go":  "/usr/local/go/bin/go",
+}
+
+func runAllowlistedTool(w http.ResponseWriter, r *http.Request) {
+    path, ok := allowedTools[r.URL.Query().Get("tool")]
+    if !ok {
+        http.Error(w, "unknown tool", http.StatusBadRequest)
+        return
+    }
+    out, err := exec.Command(path, "version").Output() // want-not CWE-88
+    if err != nil {
+        log.Println(err)
+        return
+    }
+    w.Write(out)
+}
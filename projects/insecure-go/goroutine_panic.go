@@ -0,0 +1,67 @@
+package main
+
+import (
+    "log"
+    "os"
+    "time"
+)
+
+type job struct {
+    path string
+}
+
+// Long-running worker without recover
+func startWorker(jobs <-chan job) {
+    // Vulnerable: a panic in the loop crashes the whole process
+    go func() { // want CWE-248 HIGH
+        for j := range jobs {
+            processJob(j)
+        }
+    }()
+}
+
+// Background ticker without recover
+func startTicker() {
+    // Vulnerable: long-running select loop without recover
+    go func() { // want CWE-248 HIGH
+        ticker := time.NewTicker(time.Minute)
+        for {
+            select {
+            case <-ticker.C:
+                refreshCache()
+            }
+        }
+    }()
+}
+
+// One-off goroutine without recover
+func writeAsync(path string, data []byte) {
+    // Vulnerable: short-lived file I/O goroutine
+    go func() { // want CWE-248 LOW
+        if err := os.WriteFile(path, data, 0600); err != nil {
+            panic(err)
+        }
+    }()
+}
+
+// Safe: long-running worker recovers at its top level
+func startSafeWorker(jobs <-chan job) {
+    go func() { // want-not CWE-248
+        defer func() {
+            if r := recover(); r != nil {
+                log.Printf("worker recovered: %v", r)
+            }
+        }()
+        for j := range jobs {
+            processJob(j)
+        }
+    }()
+}
+
+func processJob(j job) {
+    if j.path == "" {
+        panic("empty job path")
+    }
+}
+
+func refreshCache() {}
@@ -0,0 +1,67 @@
+package grpcsvc
+
+import (
+    "context"
+    "database/sql"
+    "io"
+    "os/exec"
+
+    "google.golang.org/grpc/metadata"
+)
+
+type userServer struct {
+    db *sql.DB
+}
+
+var _ UserServiceServer = (*userServer)(nil)
+
+// Vulnerable: unary handler concatenates a request field into SQL
+func (s *userServer) GetUser(ctx context.Context, req *GetUserRequest) (*User, error) {
+    row := s.db.QueryRowContext(ctx, "SELECT id, name FROM users WHERE id = '"+req.GetId()+"'") // want CWE-89 HIGH
+    u := &User{}
+    if err := row.Scan(&u.Id, &u.Name); err != nil {
+        return nil, err
+    }
+    return u, nil
+}
+
+// Safe: parameterized query with the same request field
+func (s *userServer) FindUser(ctx context.Context, req *GetUserRequest) (*User, error) {
+    tenant := ""
+    if md, ok := metadata.FromIncomingContext(ctx); ok {
+        if v := md.Get("x-tenant"); len(v) > 0 {
+            tenant = v[0]
+        }
+    }
+    row := s.db.QueryRowContext(ctx, "SELECT id, name FROM users WHERE id = ? AND tenant = ?", req.GetId(), tenant) // want-not CWE-89
+    u := &User{}
+    if err := row.Scan(&u.Id, &u.Name); err != nil {
+        return nil, err
+    }
+    return u, nil
+}
+
+// Vulnerable: streamed message and incoming metadata reach a shell command
+func (s *userServer) ImportUsers(stream UserService_ImportUsersServer) error {
+    source := ""
+    if md, ok := metadata.FromIncomingContext(stream.Context()); ok {
+        if v := md.Get("x-import-source"); len(v) > 0 {
+            source = v[0]
+        }
+    }
+
+    var n int32
+    for {
+        req, err := stream.Recv()
+        if err == io.EOF {
+            return stream.SendAndClose(&ImportSummary{Imported: n})
+        }
+        if err != nil {
+            return err
+        }
+        if err := exec.Command("sh", "-c", "import-users --source "+source+" "+req.GetPath()).Run(); err != nil { // want G204 HIGH
+            return err
+        }
+        n++
+    }
+}
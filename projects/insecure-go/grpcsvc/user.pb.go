@@ -0,0 +1,43 @@
+// Hand-written stand-in for protoc-gen-go output.
+// source: user.proto
+
+package grpcsvc
+
+type GetUserRequest struct {
+    Id   string
+    Name string
+}
+
+func (x *GetUserRequest) GetId() string {
+    if x != nil {
+        return x.Id
+    }
+    return ""
+}
+
+func (x *GetUserRequest) GetName() string {
+    if x != nil {
+        return x.Name
+    }
+    return ""
+}
+
+type User struct {
+    Id   string
+    Name string
+}
+
+type ImportRequest struct {
+    Path string
+}
+
+func (x *ImportRequest) GetPath() string {
+    if x != nil {
+        return x.Path
+    }
+    return ""
+}
+
+type ImportSummary struct {
+    Imported int32
+}
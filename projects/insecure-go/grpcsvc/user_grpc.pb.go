@@ -0,0 +1,22 @@
+// Hand-written stand-in for protoc-gen-go-grpc output.
+// source: user.proto
+
+package grpcsvc
+
+import (
+    "context"
+)
+
+// UserServiceServer is the server API for UserService service.
+type UserServiceServer interface {
+    GetUser(context.Context, *GetUserRequest) (*User, error)
+    FindUser(context.Context, *GetUserRequest) (*User, error)
+    ImportUsers(UserService_ImportUsersServer) error
+}
+
+// UserService_ImportUsersServer is the client-streaming server API.
+type UserService_ImportUsersServer interface {
+    Recv() (*ImportRequest, error)
+    SendAndClose(*ImportSummary) error
+    Context() context.Context
+}
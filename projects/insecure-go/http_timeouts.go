@@ -0,0 +1,53 @@
+package main
+
+import (
+    "log"
+    "net/http"
+    "net/http/httptest"
+    "time"
+)
+
+// Vulnerable: package-level ListenAndServe cannot set timeouts
+func serveDefault(mux *http.ServeMux) {
+    log.Fatal(http.ListenAndServe(":8080", mux)) // want CWE-400 MEDIUM
+}
+
+// Vulnerable: same for ListenAndServeTLS
+func serveDefaultTLS(mux *http.ServeMux) {
+    log.Fatal(http.ListenAndServeTLS(":8443", "cert.pem", "key.pem", mux)) // want CWE-400 MEDIUM
+}
+
+// Vulnerable: server literal without ReadTimeout or ReadHeaderTimeout
+func serveNoTimeouts(mux *http.ServeMux) {
+    srv := &http.Server{ // want CWE-400 MEDIUM
+        Addr:         ":8080",
+        Handler:      mux,
+        WriteTimeout: 10 * time.Second,
+    }
+    log.Fatal(srv.ListenAndServe())
+}
+
+// Safe: ReadHeaderTimeout set in the literal
+func serveConfigured(mux *http.ServeMux) {
+    srv := &http.Server{ // want-not CWE-400
+        Addr:              ":8080",
+        Handler:           mux,
+        ReadHeaderTimeout: 5 * time.Second,
+        ReadTimeout:       10 * time.Second,
+        WriteTimeout:      10 * time.Second,
+        IdleTimeout:       60 * time.Second,
+    }
+    log.Fatal(srv.ListenAndServe())
+}
+
+// Safe: ReadTimeout assigned after construction in the same function
+func serveConfiguredLater(mux *http.ServeMux) {
+    srv := &http.Server{Addr: ":8080", Handler: mux} // want-not CWE-400
+    srv.ReadTimeout = 10 * time.Second
+    log.Fatal(srv.ListenAndServe())
+}
+
+// Safe: httptest servers are never flagged
+func newTestServer(mux *http.ServeMux) *httptest.Server {
+    return httptest.NewServer(mux) // want-not CWE-400
+}
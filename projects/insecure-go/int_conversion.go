@@ -0,0 +1,46 @@
+package main
+
+import (
+    "encoding/binary"
+    "io"
+    "net/http"
+    "strconv"
+)
+
+const maxBodySize = 1 << 20
+
+// Vulnerable: unchecked Content-Length feeds an allocation
+func uploadHandler(w http.ResponseWriter, r *http.Request) {
+    n, err := strconv.Atoi(r.Header.Get("Content-Length"))
+    if err != nil {
+        return
+    }
+    buf := make([]byte, n) // want CWE-190 MEDIUM confidence=MEDIUM
+    io.ReadFull(r.Body, buf)
+}
+
+// Vulnerable: int64 length from the wire truncated to int and used for slicing and CopyN
+func readFrame(r io.Reader, w io.Writer, scratch []byte) error {
+    var length int64
+    if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+        return err
+    }
+    n := int(length)
+    _ = scratch[:n] // want CWE-190 MEDIUM confidence=MEDIUM
+    _, err := io.CopyN(w, r, int64(int32(length))) // want CWE-190 MEDIUM confidence=MEDIUM
+    return err
+}
+
+// Safe: length clamped before the allocation
+func uploadHandlerClamped(w http.ResponseWriter, r *http.Request) {
+    n, err := strconv.Atoi(r.Header.Get("Content-Length"))
+    if err != nil {
+        return
+    }
+    if n < 0 || n > maxBodySize {
+        http.Error(w, "body too large", http.StatusRequestEntityTooLarge)
+        return
+    }
+    buf := make([]byte, n) // want-not CWE-190
+    io.ReadFull(r.Body, buf)
+}
@@ -0,0 +1,53 @@
+// want-config --include-tests
+
+package main
+
+import (
+    "crypto/tls"
+    "net/http"
+    "net/http/httptest"
+    "os"
+    "testing"
+)
+
+// Vulnerable even in tests: live-looking vendor key
+const stripeLiveKey = "sk_live_4eC39HqLyjWDarjt" // want G101 HIGH inTest=true
+
+// Downgraded in tests: ordinary test credential. _test.go files are
+// only scanned with --include-tests, and its MEDIUM takes precedence over the
+// LOW location rule, which applies to testdata/, examples/ and docs/
+const testDBPassword = "integration-Passw0rd!" // want G101 MEDIUM inTest=true
+
+func TestChargeEndpoint(t *testing.T) {
+    srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        if r.Header.Get("Authorization") != "Bearer "+stripeLiveKey {
+            w.WriteHeader(http.StatusUnauthorized)
+        }
+    }))
+    defer srv.Close()
+
+    // Skipped in tests: httptest TLS server client
+    client := srv.Client()
+    client.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify = true // want-not G402
+
+    req, _ := http.NewRequest(http.MethodPost, srv.URL, nil)
+    req.Header.Set("Authorization", "Bearer "+stripeLiveKey)
+    resp, err := client.Do(req)
+    if err != nil {
+        t.Fatal(err)
+    }
+    resp.Body.Close()
+}
+
+func TestStagingEndpoint(t *testing.T) {
+    // Vulnerable in tests: InsecureSkipVerify against a real host
+    client := &http.Client{Transport: &http.Transport{
+        TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // want G402 HIGH inTest=true
+    }}
+    if os.Getenv("STAGING") == "" {
+        t.Skip("STAGING not set")
+    }
+    t.Setenv("DB_PASSWORD", testDBPassword)
+    // Ignored in tests unless --strict: unhandled error
+    client.Get("https://staging.example.com/health") // want-not G104
+}
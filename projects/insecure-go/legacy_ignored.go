@@ -0,0 +1,14 @@
+//sast:ignore-file
+
+package main
+
+import (
+    "os"
+    "os/exec"
+)
+
+// Suppressed: whole file ignored, slated for removal
+func legacyRunner() {
+    password := "legacy-Passw0rd!" // want-not G101
+    exec.Command("sh", "-c", os.Getenv("LEGACY_CMD")+" --password "+password).Run() // want-not G204
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+    "os"
+    "os/exec"
+)
+
+// The directive below is past the first 10 lines and must be ignored.
+//
+//
+//
+//
+//sast:ignore-file
+
+// Vulnerable: not suppressed
+func lateDirectiveRunner() {
+    exec.Command("sh", "-c", os.Getenv("LATE_CMD")).Run() // want G204 HIGH
+}
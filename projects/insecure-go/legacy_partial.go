@@ -0,0 +1,19 @@
+// Package-level helpers kept for the v1 API.
+//sast:ignore-file G101
+
+package main
+
+import (
+    "os"
+    "os/exec"
+)
+
+// Suppressed: G101 ignored for this file
+var legacyAPIKey = "sk_live_0f1e2d3c4b5a6978" // want-not G101
+
+// Vulnerable: other rules still apply
+func legacyExport() {
+    exec.Command("sh", "-c", "export-v1 "+os.Getenv("EXPORT_ARGS")).Run() // want G204 HIGH
+    // Suppressed: //nosec still works alongside the file directive
+    exec.Command("sh", "-c", os.Getenv("EXPORT_HOOK")).Run() // #nosec G204 // want-not G204
+}
@@ -0,0 +1,16 @@
+package lookup
+
+import (
+    "database/sql"
+    "log"
+)
+
+// Reachable: exported function of a library package is an entry point
+func ExportedLookup(db *sql.DB, userInput string) {
+    // Vulnerable and reachable
+    rows, err := db.Query("SELECT * FROM accounts WHERE name = '" + userInput + "'") // want CWE-89 HIGH reachable=true
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
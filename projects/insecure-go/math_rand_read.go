@@ -0,0 +1,41 @@
+package main
+
+import (
+    crand "crypto/rand"
+    "encoding/hex"
+    "math/rand"
+)
+
+// Vulnerable: math/rand.Read used for a session token
+// (math/rand.Read is deprecated since Go 1.20, and math/rand/v2 has no Read)
+func sessionToken() string {
+    b := make([]byte, 32)
+    rand.Read(b) // want CWE-338 HIGH
+    return hex.EncodeToString(b)
+}
+
+// Vulnerable: math/rand.Read used for key material
+func newAESKey() []byte {
+    key := make([]byte, 32)
+    if _, err := rand.Read(key); err != nil { // want CWE-338 HIGH
+        panic(err)
+    }
+    return key
+}
+
+// Vulnerable: Read on a seeded math/rand source
+func nonceFromSource(seed int64) []byte {
+    r := rand.New(rand.NewSource(seed))
+    nonce := make([]byte, 12)
+    r.Read(nonce) // want CWE-338 HIGH
+    return nonce
+}
+
+// Safe: crypto/rand.Read
+func secureSessionToken() string {
+    b := make([]byte, 32)
+    if _, err := crand.Read(b); err != nil { // want-not CWE-338
+        panic(err)
+    }
+    return hex.EncodeToString(b)
+}
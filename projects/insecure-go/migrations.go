@@ -0,0 +1,20 @@
+package main
+
+// Safe: constant DDL mentioning "password" is never concatenated or sent
+// through a tainted path, so neither injection nor secrets rules should fire.
+const createUsersTable = ` // want-not G101, CWE-89
+CREATE TABLE users (
+    id            SERIAL PRIMARY KEY,
+    email         TEXT NOT NULL UNIQUE,
+    password_hash TEXT NOT NULL,
+    password_salt TEXT NOT NULL,
+    api_token     TEXT
+);
+`
+
+const addPasswordResetColumn = "ALTER TABLE users ADD COLUMN password_reset_token TEXT" // want-not G101, CWE-89
+
+var migrations = []string{
+    createUsersTable,
+    addPasswordResetColumn,
+}
@@ -0,0 +1,74 @@
+// want-config --enable=robustness
+
+package main
+
+import (
+    "context"
+    "database/sql"
+    "log"
+    "net"
+    "net/http"
+    "time"
+)
+
+// Robustness: http.Get has no context or deadline
+func fetchStatus() {
+    resp, err := http.Get("https://status.example.com/health") // want CWE-1088 INFO
+    if err != nil {
+        log.Println(err)
+        return
+    }
+    resp.Body.Close()
+}
+
+// Robustness: ctx is in scope but db.Query ignores it
+func listUsers(ctx context.Context, db *sql.DB) {
+    rows, err := db.Query("SELECT id FROM users") // want CWE-1088 LOW
+    if err != nil {
+        log.Println(err)
+        return
+    }
+    defer rows.Close()
+}
+
+// Robustness: net.Dial without timeout
+func dialBackend() {
+    conn, err := net.Dial("tcp", "backend.internal:9000") // want CWE-1088 LOW
+    if err != nil {
+        log.Println(err)
+        return
+    }
+    conn.Close()
+}
+
+// Safe: no context.Context parameter available, no suggestion for db.Query
+func listUsersNoContext(db *sql.DB) {
+    rows, err := db.Query("SELECT id FROM users") // want-not CWE-1088
+    if err != nil {
+        log.Println(err)
+        return
+    }
+    defer rows.Close()
+}
+
+// Safe: context-aware variants
+func listUsersWithContext(ctx context.Context, db *sql.DB) {
+    rows, err := db.QueryContext(ctx, "SELECT id FROM users") // want-not CWE-1088
+    if err != nil {
+        log.Println(err)
+        return
+    }
+    defer rows.Close()
+
+    req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://status.example.com/health", nil)
+    if err != nil {
+        return
+    }
+    if resp, err := http.DefaultClient.Do(req); err == nil { // want-not CWE-1088
+        resp.Body.Close()
+    }
+
+    if conn, err := net.DialTimeout("tcp", "backend.internal:9000", 5*time.Second); err == nil { // want-not CWE-1088
+        conn.Close()
+    }
+}
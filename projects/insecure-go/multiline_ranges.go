@@ -0,0 +1,30 @@
+package main
+
+import (
+	"crypto/tls"
+	"database/sql"
+	"fmt"
+	"net/http"
+)
+
+// Tab-indented on purpose: columns are byte offsets, a tab counts as one.
+
+// Vulnerable: finding spans the whole multi-line composite literal
+func insecureTLSClient() *http.Client {
+	cfg := &tls.Config{ // want G402 HIGH col=9 end=17
+		MinVersion:         tls.VersionTLS10,
+		InsecureSkipVerify: true,
+	}
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: cfg}}
+}
+
+// Vulnerable: multi-line Sprintf, tainted argument on its own line
+func multilineSprintf(db *sql.DB, r *http.Request) {
+	if r.Method != http.MethodGet {
+		return
+	}
+	db.Query(fmt.Sprintf( // want CWE-89 HIGH col=2 end=29
+		"SELECT * FROM orders WHERE customer = '%s' AND status = 'open'",
+		r.FormValue("customer"),
+	))
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+    "sync"
+)
+
+type counter struct {
+    mu    sync.Mutex
+    value int
+}
+
+func (c *counter) inc() {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.value++
+}
+
+type registry struct {
+    sync.RWMutex
+    entries map[string]string
+}
+
+func (r *registry) get(key string) string {
+    r.RLock()
+    defer r.RUnlock()
+    return r.entries[key]
+}
+
+// Vulnerable: struct containing a locked mutex passed by value
+func snapshotCounter(c counter) int { // want CWE-667 HIGH
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    return c.value
+}
+
+// Vulnerable: embedded RWMutex copied with the outer struct
+func lookupCopy(r registry, key string) string { // want CWE-667 HIGH
+    return r.get(key)
+}
+
+// Vulnerable: value assignment copies the mutex after first use
+func copyAfterUse(c *counter) {
+    c.inc()
+    copied := *c // want CWE-667 HIGH
+    copied.inc()
+}
+
+// Safe: pointer receiver and pointer parameter share one lock
+func snapshotCounterPtr(c *counter) int { // want-not CWE-667
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    return c.value
+}
+
+// Safe: a local type named Mutex is not sync.Mutex
+type Mutex struct {
+    owner string
+}
+
+type fakeLocked struct {
+    mu Mutex
+}
+
+func describeFake(f fakeLocked) string { // want-not CWE-667
+    return f.mu.owner
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+    "database/sql"
+    "log"
+    "net/http"
+)
+
+// In-memory cache whose method happens to be named Query
+type cache struct {
+    items map[string]string
+}
+
+func (c *cache) Query(key string) string {
+    return c.items[key]
+}
+
+func (c *cache) Exec(key, value string) {
+    c.items[key] = value
+}
+
+// Safe: Query/Exec on a non-database type with request input
+func cacheLookupHandler(c *cache, w http.ResponseWriter, r *http.Request) {
+    key := "user:" + r.URL.Query().Get("id")
+    c.Exec(key, r.FormValue("value")) // want-not CWE-89
+    w.Write([]byte(c.Query(key))) // want-not CWE-89
+}
+
+// Vulnerable: the same input reaching (*database/sql.DB).Query
+func dbLookupHandler(db *sql.DB, w http.ResponseWriter, r *http.Request) {
+    rows, err := db.Query("SELECT * FROM users WHERE id = " + r.URL.Query().Get("id")) // want CWE-89 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Vulnerable: (*database/sql.Tx).Exec
+func txDeleteHandler(tx *sql.Tx, r *http.Request) {
+    if _, err := tx.Exec("DELETE FROM users WHERE id = " + r.FormValue("id")); err != nil { // want CWE-89 HIGH
+        log.Fatal(err)
+    }
+}
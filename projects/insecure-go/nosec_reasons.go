@@ -0,0 +1,17 @@
+package main
+
+import (
+    "os"
+    "os/exec"
+)
+
+// Suppressed with a reason: recorded in the audit section
+var fixtureCredential = "Fixture-Passw0rd!" //nosec G101 reason:"test fixture, not production credential" // want-not G101
+
+// Suppressed without a reason: tagged [no reason given]
+var legacyCredential = "Legacy-Passw0rd!" //nosec G101 // want-not G101
+
+func auditedCommands() {
+    // Suppressed with a reason on a multi-rule directive
+    exec.Command("sh", "-c", os.Getenv("BUILD_CMD")).Run() //nosec G204 G104 reason:"value set by CI pipeline only" // want-not G204, G104
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+    "context"
+    "encoding/json"
+    "log"
+    "net/http"
+
+    "go.mongodb.org/mongo-driver/bson"
+    "go.mongodb.org/mongo-driver/mongo"
+)
+
+// Vulnerable: $where evaluates user-supplied JavaScript
+func mongoWhereInjection(coll *mongo.Collection, r *http.Request) {
+    filter := bson.D{{Key: "$where", Value: r.URL.Query().Get("expr")}} // want CWE-943 HIGH
+    cur, err := coll.Find(context.TODO(), filter)
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer cur.Close(context.TODO())
+}
+
+// Vulnerable: decoded JSON value may be an operator object like {"$gt": ""}
+func mongoTypeConfusion(coll *mongo.Collection, r *http.Request) {
+    var body map[string]interface{}
+    if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+        return
+    }
+    filter := bson.M{"username": body["username"], "password": body["password"]} // want CWE-943 MEDIUM
+    if err := coll.FindOne(context.TODO(), filter).Err(); err != nil {
+        log.Println(err)
+    }
+}
+
+// Safe: value asserted to string before use
+func mongoTypedFilter(coll *mongo.Collection, r *http.Request) {
+    var body map[string]interface{}
+    if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+        return
+    }
+    username, ok := body["username"].(string)
+    if !ok {
+        return
+    }
+    if err := coll.FindOne(context.TODO(), bson.M{"username": username}).Err(); err != nil { // want-not CWE-943
+        log.Println(err)
+    }
+}
+
+// Safe: field name checked against an allowlist
+func mongoAllowlistedSort(coll *mongo.Collection, r *http.Request) {
+    allowed := map[string]bool{"name": true, "created_at": true}
+    field := r.URL.Query().Get("field")
+    if !allowed[field] {
+        return
+    }
+    cur, err := coll.Find(context.TODO(), bson.D{{Key: field, Value: bson.D{{Key: "$exists", Value: true}}}}) // want-not CWE-943
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer cur.Close(context.TODO())
+}
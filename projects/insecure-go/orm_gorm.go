@@ -0,0 +1,43 @@
+package main
+
+import (
+    "net/http"
+
+    "gorm.io/gorm"
+)
+
+type Account struct {
+    ID   uint
+    Name string
+}
+
+// Vulnerable: Raw follows the same taint logic as db.Query
+func gormRaw(db *gorm.DB, r *http.Request) {
+    var accounts []Account
+    db.Raw("SELECT * FROM accounts WHERE name = '" + r.FormValue("name") + "'").Scan(&accounts) // want CWE-89 HIGH
+}
+
+// Vulnerable: Where with a concatenated condition
+func gormWhereConcat(db *gorm.DB, r *http.Request) {
+    var accounts []Account
+    db.Where("name = '" + r.FormValue("name") + "'").Find(&accounts) // want CWE-89 HIGH
+}
+
+// Vulnerable: Order/Group/Having cannot be parameterized
+func gormOrderBy(db *gorm.DB, r *http.Request) {
+    var accounts []Account
+    db.Order(r.URL.Query().Get("sort")).Find(&accounts) // want CWE-89 HIGH
+    db.Model(&Account{}).Group(r.URL.Query().Get("group")).Having(r.URL.Query().Get("having")).Find(&accounts) // want CWE-89 HIGH, CWE-89 HIGH
+}
+
+// Safe: Where with a ? placeholder and args
+func gormWherePlaceholder(db *gorm.DB, r *http.Request) {
+    var accounts []Account
+    db.Where("name = ?", r.FormValue("name")).Find(&accounts) // want-not CWE-89
+}
+
+// Safe: constant Order
+func gormOrderConstant(db *gorm.DB) {
+    var accounts []Account
+    db.Order("name asc").Find(&accounts) // want-not CWE-89
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+    "log"
+    "net/http"
+
+    "github.com/jmoiron/sqlx"
+)
+
+// Vulnerable: Queryx with a concatenated query
+func sqlxQueryx(db *sqlx.DB, r *http.Request) {
+    rows, err := db.Queryx("SELECT * FROM accounts WHERE name = '" + r.FormValue("name") + "'") // want CWE-89 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Vulnerable: Select and Get with concatenated queries
+func sqlxSelectGet(db *sqlx.DB, r *http.Request) {
+    var names []string
+    if err := db.Select(&names, "SELECT name FROM accounts WHERE team = "+r.FormValue("team")); err != nil { // want CWE-89 HIGH
+        log.Fatal(err)
+    }
+    var count int
+    if err := db.Get(&count, "SELECT count(*) FROM accounts WHERE team = "+r.FormValue("team")); err != nil { // want CWE-89 HIGH
+        log.Fatal(err)
+    }
+}
+
+// Safe: bind parameters
+func sqlxParameterized(db *sqlx.DB, r *http.Request) {
+    rows, err := db.Queryx("SELECT * FROM accounts WHERE name = ?", r.FormValue("name")) // want-not CWE-89
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
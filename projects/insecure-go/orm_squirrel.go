@@ -0,0 +1,53 @@
+package main
+
+import (
+    "database/sql"
+    "log"
+    "net/http"
+
+    sq "github.com/Masterminds/squirrel"
+)
+
+// Vulnerable: sq.Expr built with concatenation
+func squirrelExprConcat(db *sql.DB, r *http.Request) {
+    query, args, err := sq.Select("*").From("accounts").
+        Where(sq.Expr("name = '" + r.FormValue("name") + "'")). // want CWE-89 HIGH
+        ToSql()
+    if err != nil {
+        log.Fatal(err)
+    }
+    rows, err := db.Query(query, args...)
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Vulnerable: OrderBy with request input
+func squirrelOrderBy(db *sql.DB, r *http.Request) {
+    query, args, err := sq.Select("*").From("accounts").OrderBy(r.URL.Query().Get("sort")).ToSql() // want CWE-89 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    rows, err := db.Query(query, args...)
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Safe: sq.Eq and sq.Expr placeholders
+func squirrelParameterized(db *sql.DB, r *http.Request) {
+    query, args, err := sq.Select("*").From("accounts").
+        Where(sq.Eq{"name": r.FormValue("name")}). // want-not CWE-89
+        Where(sq.Expr("created_at > ?", r.FormValue("since"))). // want-not CWE-89
+        ToSql()
+    if err != nil {
+        log.Fatal(err)
+    }
+    rows, err := db.Query(query, args...)
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+    "net/http"
+    "os"
+    "path/filepath"
+    "strings"
+)
+
+const dataDir = "/var/data"
+
+// Vulnerable: "../../etc/passwd" escapes dataDir after Join cleans the path
+func downloadHandler(w http.ResponseWriter, r *http.Request) {
+    path := filepath.Join(dataDir, r.URL.Query().Get("file")) // want CWE-22 HIGH
+    data, err := os.ReadFile(path)
+    if err != nil {
+        http.NotFound(w, r)
+        return
+    }
+    w.Write(data)
+}
+
+// Vulnerable: several tainted segments joined under a fixed base
+func avatarHandler(w http.ResponseWriter, r *http.Request) {
+    path := filepath.Join(dataDir, "avatars", r.FormValue("user"), r.FormValue("name")) // want CWE-22 HIGH
+    http.ServeFile(w, r, path)
+}
+
+// Safe: result checked against the base after Join
+func downloadHandlerChecked(w http.ResponseWriter, r *http.Request) {
+    path := filepath.Join(dataDir, r.URL.Query().Get("file")) // want-not CWE-22
+    if !strings.HasPrefix(path, dataDir+string(filepath.Separator)) {
+        http.Error(w, "invalid path", http.StatusBadRequest)
+        return
+    }
+    data, err := os.ReadFile(path)
+    if err != nil {
+        http.NotFound(w, r)
+        return
+    }
+    w.Write(data)
+}
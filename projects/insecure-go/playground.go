@@ -0,0 +1,14 @@
+//go:build ignore
+
+// Scratch program excluded from normal builds.
+package main
+
+import (
+    "os"
+    "os/exec"
+)
+
+func main() {
+    // Vulnerable: command injection, but the file is build-ignored
+    exec.Command("sh", "-c", os.Args[1]).Run() // want-not G204
+}
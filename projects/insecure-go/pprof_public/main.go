@@ -0,0 +1,20 @@
+package main
+
+import (
+    "expvar"
+    "log"
+    "net/http"
+    _ "net/http/pprof" // want CWE-489 HIGH
+)
+
+var requests = expvar.NewInt("requests")
+
+// Vulnerable: pprof and expvar handlers on DefaultServeMux, served on all interfaces
+func main() {
+    http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+        requests.Add(1)
+        w.Write([]byte("ok"))
+    })
+    http.Handle("/metrics/vars", expvar.Handler()) // want CWE-489 MEDIUM
+    log.Fatal(http.ListenAndServe(":8080", nil))
+}
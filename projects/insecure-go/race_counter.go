@@ -0,0 +1,74 @@
+package main
+
+import (
+    "fmt"
+    "net/http"
+    "sync"
+)
+
+// Racy request counter and cache
+var (
+    requestCount int
+    visitors     = map[string]int{}
+)
+
+func racyHandler(w http.ResponseWriter, r *http.Request) {
+    go func() {
+        // Vulnerable: counter incremented from many goroutines without atomics
+        requestCount++ // want CWE-362 MEDIUM
+        // Vulnerable: package-level map written without a lock
+        visitors[r.RemoteAddr]++ // want CWE-362 MEDIUM
+    }()
+    fmt.Fprintln(w, "ok")
+}
+
+type racyStats struct {
+    hits map[string]int
+}
+
+func (s *racyStats) record(path string) {
+    go func() {
+        // Vulnerable: struct-field map written without a lock
+        s.hits[path]++ // want CWE-362 MEDIUM
+    }()
+}
+
+// Safe: mutex-protected equivalent
+type safeStats struct {
+    mu    sync.Mutex
+    count int
+    hits  map[string]int
+}
+
+func (s *safeStats) handler(w http.ResponseWriter, r *http.Request) {
+    go func() {
+        s.mu.Lock()
+        defer s.mu.Unlock()
+        s.count++ // want-not CWE-362
+        s.hits[r.URL.Path]++ // want-not CWE-362
+    }()
+    fmt.Fprintln(w, "ok")
+}
+
+// Not flagged: struct carries a sync.Mutex, so the write is trusted even
+// though no Lock/Unlock is visible around it
+type lockedCache struct {
+    mu      sync.Mutex
+    entries map[string]string
+}
+
+func (c *lockedCache) put(key, value string) {
+    go func() {
+        c.entries[key] = value // want-not CWE-362
+    }()
+}
+
+// Safe: sync.Map needs no external lock
+var safeVisitors sync.Map
+
+func syncMapHandler(w http.ResponseWriter, r *http.Request) {
+    go func() {
+        safeVisitors.Store(r.RemoteAddr, true) // want-not CWE-362
+    }()
+    fmt.Fprintln(w, "ok")
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+    "net/http"
+    "strings"
+)
+
+// Vulnerable: query parameter placed in a response header
+// (net/http has replaced CR/LF in header values with spaces since Go 1.0,
+// the minimum safe Go version)
+func redirectHeader(w http.ResponseWriter, r *http.Request) {
+    w.Header().Set("Location", r.URL.Query().Get("next")) // want CWE-113 HIGH
+    w.WriteHeader(http.StatusFound)
+}
+
+// Vulnerable: form value added as a header
+func languageHeader(w http.ResponseWriter, r *http.Request) {
+    w.Header().Add("Content-Language", r.FormValue("lang")) // want CWE-113 HIGH
+}
+
+// Defense-in-depth: request header reflected into a cookie; SetCookie
+// drops CR/LF via sanitizeCookieValue, so it cannot split on any supported Go
+func trackingCookie(w http.ResponseWriter, r *http.Request) {
+    http.SetCookie(w, &http.Cookie{Name: "ref", Value: r.Header.Get("Referer")}) // want CWE-113 INFO
+}
+
+// Safe: CR and LF stripped before use
+func sanitizedHeader(w http.ResponseWriter, r *http.Request) {
+    next := r.URL.Query().Get("next")
+    next = strings.ReplaceAll(next, "\r", "")
+    next = strings.ReplaceAll(next, "\n", "")
+    w.Header().Set("Location", next) // want-not CWE-113
+    w.WriteHeader(http.StatusFound)
+}
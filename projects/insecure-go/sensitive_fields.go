@@ -0,0 +1,39 @@
+package main
+
+import (
+    "encoding/json"
+    "net/http"
+)
+
+type storedUser struct {
+    ID           int    `json:"id"`
+    Email        string `json:"email"`
+    PasswordHash string `json:"password_hash"`
+    Salt         string
+    APIToken     string `json:"api_token"`
+    IsAdmin      bool   `json:"is_admin"`
+}
+
+type publicUser struct {
+    ID           int    `json:"id"`
+    Email        string `json:"email"`
+    PasswordHash string `json:"-"`
+    Salt         string `json:"-"`
+}
+
+// Vulnerable: json.Marshal of a struct with untagged sensitive fields
+func userJSON(u storedUser) []byte {
+    b, _ := json.Marshal(u) // want CWE-200 HIGH
+    return b
+}
+
+// Vulnerable: json.NewEncoder(w).Encode of the same struct
+func userHandler(w http.ResponseWriter, r *http.Request) {
+    u := &storedUser{ID: 1, Email: "alice@example.com"}
+    json.NewEncoder(w).Encode(u) // want CWE-200 HIGH
+}
+
+// Safe: sensitive fields excluded with json:"-"
+func publicUserHandler(w http.ResponseWriter, r *http.Request) {
+    json.NewEncoder(w).Encode(publicUser{ID: 1, Email: "alice@example.com"}) // want-not CWE-200
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+    "database/sql"
+    "log"
+    "net/http"
+    "os/exec"
+    "strings"
+)
+
+func sanitize(s string) string {
+    return strings.Map(func(r rune) rune {
+        if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+            return r
+        }
+        return -1
+    }, s)
+}
+
+// SQL injection through a shadowed sanitized variable
+func shadowedSQLInjection(db *sql.DB, r *http.Request) {
+    userInput := r.URL.Query().Get("id")
+    if userInput != "" {
+        // Sanitization only applies to the inner binding
+        userInput := sanitize(userInput)
+        log.Println("sanitized:", userInput)
+    }
+
+    // Vulnerable: outer, unsanitized userInput reaches the sink
+    rows, err := db.Query("SELECT * FROM users WHERE id = '" + userInput + "'") // want CWE-457 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Command injection through a shadowed sanitized variable
+func shadowedCommandInjection(r *http.Request) {
+    host := r.FormValue("host")
+    for i := 0; i < 1; i++ {
+        host := sanitize(host)
+        _ = host
+    }
+
+    // Vulnerable: outer, unsanitized host reaches the sink
+    if err := exec.Command("sh", "-c", "ping -c 1 "+host).Run(); err != nil { // want CWE-457 HIGH
+        log.Fatal(err)
+    }
+}
+
+// Safe: sanitization reassigns the same binding
+func reassignedSanitizedInput(db *sql.DB, r *http.Request) {
+    userInput := r.URL.Query().Get("id")
+    if userInput != "" {
+        userInput = sanitize(userInput)
+    }
+
+    rows, err := db.Query("SELECT * FROM users WHERE id = '" + userInput + "'") // want-not CWE-457
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
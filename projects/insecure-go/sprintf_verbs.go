@@ -0,0 +1,69 @@
+package main
+
+import (
+    "database/sql"
+    "fmt"
+    "log"
+    "net/http"
+    "strconv"
+)
+
+// Vulnerable: %v propagates taint like %s
+func sprintfVerbV(db *sql.DB, r *http.Request) {
+    query := fmt.Sprintf("SELECT * FROM users WHERE name = '%v'", r.FormValue("name"))
+    rows, err := db.Query(query) // want CWE-89 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Vulnerable (quoted, not parameterized): %q adds Go quoting, not SQL escaping
+func sprintfVerbQ(db *sql.DB, r *http.Request) {
+    query := fmt.Sprintf("SELECT * FROM users WHERE name = %q", r.FormValue("name"))
+    rows, err := db.Query(query) // want CWE-89 MEDIUM
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Safe: value converted with strconv.Atoi and formatted with %d
+func sprintfVerbD(db *sql.DB, r *http.Request) {
+    id, err := strconv.Atoi(r.FormValue("id"))
+    if err != nil {
+        return
+    }
+    query := fmt.Sprintf("SELECT * FROM users WHERE id = %d", id)
+    rows, err := db.Query(query) // want-not CWE-89
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Safe: value converted with strconv.ParseFloat and formatted with %f
+func sprintfVerbF(db *sql.DB, r *http.Request) {
+    price, err := strconv.ParseFloat(r.FormValue("price"), 64)
+    if err != nil {
+        return
+    }
+    query := fmt.Sprintf("SELECT * FROM items WHERE price < %f", price)
+    rows, err := db.Query(query) // want-not CWE-89
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
+
+// Vulnerable: %d formats the tainted value, but the string form is also used
+func sprintfMixedVerbs(db *sql.DB, r *http.Request) {
+    raw := r.FormValue("id")
+    id, _ := strconv.Atoi(raw)
+    query := fmt.Sprintf("SELECT * FROM users WHERE id = %d OR name = '%s'", id, raw)
+    rows, err := db.Query(query) // want CWE-89 HIGH
+    if err != nil {
+        log.Fatal(err)
+    }
+    defer rows.Close()
+}
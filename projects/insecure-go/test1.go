@@ -1,16 +1,23 @@
+package main
+
+import (
+    "net"
+    "unsafe"
+)
+
 func Float64bits(f float64) uint64 {
-// G103 (CWE-242): Use of unsafe calls should be audited (Confidence: HIGH, Severity: LOW)
-return *(*uint64)(unsafe.Pointer(&f))
+    // G103 (CWE-242): Use of unsafe calls should be audited (Confidence: HIGH, Severity: LOW)
+    return *(*uint64)(unsafe.Pointer(&f)) // want G103 LOW
 }
 
-func main() {
-username := "admin"
-// G101 (CWE-798): Potential hardcoded credentials (Confidence: LOW, Severity: HIGH)
-var password = "Flag{keep_your_eyes_open)"
+func gosecSamples() {
+    username := "admin"
+    // G101 (CWE-798): Potential hardcoded credentials (Confidence: LOW, Severity: HIGH)
+    var password = "Flag{keep_your_eyes_open)" // want G101 HIGH
 
-println("Doing something with: ", username, password)
+    println("Doing something with: ", username, password)
 
-// G12 (CWE-200): Binds to all network interfaces (Confidence: HIGH, Severity: MEDIUM)
-// G104 (CWE-703): Errors unhandled. (Confidence: HIGH, Severity: LOW)
-net.Listen("tcp", "@.0.0.0:80")
-}
\ No newline at end of file
+    // G102 (CWE-200): Binds to all network interfaces (Confidence: HIGH, Severity: MEDIUM)
+    // G104 (CWE-703): Errors unhandled. (Confidence: HIGH, Severity: LOW)
+    net.Listen("tcp", "0.0.0.0:80") // want G102 MEDIUM, G104 LOW
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+    "crypto/hmac"
+    "crypto/sha256"
+    "crypto/subtle"
+    "encoding/hex"
+    "net/http"
+    "os"
+    "time"
+)
+
+var webhookSecret = []byte(os.Getenv("WEBHOOK_SECRET"))
+
+func computeMAC(body []byte) string {
+    m := hmac.New(sha256.New, webhookSecret)
+    m.Write(body)
+    return hex.EncodeToString(m.Sum(nil))
+}
+
+// Vulnerable: MAC compared with ==
+func verifyWebhook(body []byte, r *http.Request) bool {
+    mac := r.Header.Get("X-Signature")
+    expected := computeMAC(body)
+    if mac == expected { // want CWE-208 HIGH
+        return true
+    }
+    return false
+}
+
+// Vulnerable: token compared with != and a sleep used to "mask" timing
+func checkAPIToken(token, storedToken string) bool {
+    if token != storedToken { // want CWE-208 HIGH
+        time.Sleep(100 * time.Millisecond) // want CWE-208 HIGH
+        return false
+    }
+    return true
+}
+
+// Vulnerable: password hash compared with ==
+func checkPasswordHash(passwordHash, storedHash string) bool {
+    return passwordHash == storedHash // want CWE-208 HIGH
+}
+
+// Safe: hmac.Equal
+func verifyWebhookHMAC(body []byte, r *http.Request) bool {
+    mac, err := hex.DecodeString(r.Header.Get("X-Signature"))
+    if err != nil {
+        return false
+    }
+    m := hmac.New(sha256.New, webhookSecret)
+    m.Write(body)
+    return hmac.Equal(mac, m.Sum(nil)) // want-not CWE-208
+}
+
+// Safe: subtle.ConstantTimeCompare
+func checkAPITokenConstantTime(token, storedToken string) bool {
+    return subtle.ConstantTimeCompare([]byte(token), []byte(storedToken)) == 1 // want-not CWE-208
+}
+
+// Safe: non-secret names compared with ==
+func sameRegion(region, expectedRegion string) bool {
+    return region == expectedRegion // want-not CWE-208
+}
@@ -4,8 +4,9 @@ import (
     "database/sql"
     "fmt"
     "log"
-    "os"
+    "math/rand"
     "os/exec"
+    "time"
     _ "github.com/mattn/go-sqlite3"
 )
 
@@ -16,10 +17,9 @@ func sqlInjection(userInput string) {
         log.Fatal(err)
     }
     defer db.Close()
-
     // Vulnerable: concatenating user input
     query := fmt.Sprintf("SELECT * FROM users WHERE id = %s", userInput)
-    rows, err := db.Query(query)
+    rows, err := db.Query(query) // want CWE-89 HIGH
     if err != nil {
         log.Fatal(err)
     }
@@ -28,7 +28,7 @@ func sqlInjection(userInput string) {
 
 // Command injection
 func commandInjection(userInput string) {
-    cmd := exec.Command("sh", "-c", "echo "+userInput)
+    cmd := exec.Command("sh", "-c", "echo "+userInput) // want G204 HIGH
     output, err := cmd.Output()
     if err != nil {
         log.Fatal(err)
@@ -38,8 +38,8 @@ func commandInjection(userInput string) {
 
 // Hardcoded credentials
 func hardcodedCredentials() {
-    password := "SuperSecret123!"
-    apiKey := "sk_live_1234567890abcdef"
+    password := "SuperSecret123!" // want G101 HIGH reachable=true
+    apiKey := "sk_live_1234567890abcdef" // want G101 HIGH reachable=true
     fmt.Println(password, apiKey)
 }
 
@@ -47,7 +47,7 @@ func hardcodedCredentials() {
 func insecureRandomness() {
     // Using math/rand instead of crypto/rand
     rand.Seed(time.Now().UnixNano())
-    token := rand.Intn(1000000)
+    token := rand.Intn(1000000) // want G404 HIGH
     fmt.Println(token)
 }
 
@@ -56,4 +56,6 @@ func main() {
     commandInjection("; cat /etc/passwd")
     hardcodedCredentials()
     insecureRandomness()
+    gosecSamples()
+    legacyImport()
 }
@@ -0,0 +1,68 @@
+package main
+
+import (
+    "crypto/dsa"
+    "crypto/ecdsa"
+    "crypto/elliptic"
+    "crypto/rand"
+    "crypto/rsa"
+)
+
+// Vulnerable: RSA key below 2048 bits
+func weakRSAKey() (*rsa.PrivateKey, error) {
+    return rsa.GenerateKey(rand.Reader, 1024) // want CWE-326 HIGH
+}
+
+// Vulnerable, with a note: RSA key below 4096 bits
+func defaultRSAKey() (*rsa.PrivateKey, error) {
+    return rsa.GenerateKey(rand.Reader, 2048) // want CWE-326 MEDIUM
+}
+
+// Vulnerable: bit size held in a constant
+const legacyKeyBits = 512
+
+func legacyRSAKey() (*rsa.PrivateKey, error) {
+    return rsa.GenerateKey(rand.Reader, legacyKeyBits) // want CWE-326 HIGH
+}
+
+// Vulnerable: ECDSA on P-224
+func weakECDSAKey() (*ecdsa.PrivateKey, error) {
+    return ecdsa.GenerateKey(elliptic.P224(), rand.Reader) // want CWE-326 LOW
+}
+
+// Vulnerable: DSA parameters below L2048N256
+func weakDSAKey() (*dsa.PrivateKey, error) {
+    priv := new(dsa.PrivateKey)
+    if err := dsa.GenerateParameters(&priv.Parameters, rand.Reader, dsa.L1024N160); err != nil { // want CWE-326 HIGH
+        return nil, err
+    }
+    if err := dsa.GenerateKey(priv, rand.Reader); err != nil {
+        return nil, err
+    }
+    return priv, nil
+}
+
+// Safe: 4096-bit RSA and P-256 ECDSA
+func strongKeys() (*rsa.PrivateKey, *ecdsa.PrivateKey, error) {
+    rsaKey, err := rsa.GenerateKey(rand.Reader, 4096) // want-not CWE-326
+    if err != nil {
+        return nil, nil, err
+    }
+    ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader) // want-not CWE-326
+    if err != nil {
+        return nil, nil, err
+    }
+    return rsaKey, ecKey, nil
+}
+
+// Safe: DSA with L2048N256
+func dsaKeyL2048() (*dsa.PrivateKey, error) {
+    priv := new(dsa.PrivateKey)
+    if err := dsa.GenerateParameters(&priv.Parameters, rand.Reader, dsa.L2048N256); err != nil { // want-not CWE-326
+        return nil, err
+    }
+    if err := dsa.GenerateKey(priv, rand.Reader); err != nil {
+        return nil, err
+    }
+    return priv, nil
+}